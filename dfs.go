@@ -1,41 +1,118 @@
 package mafsa
 
-import "sort"
+// Words iterates over every word stored in the tree, in lexicographical
+// order, calling yield once per word. It is compatible with the standard
+// library's iter.Seq[string] (range-over-func): returning false from yield
+// stops the walk immediately, so callers that only need the first few
+// matches no longer have to drain a channel or leak a goroutine.
+func (t *MinTree) Words(yield func(string) bool) {
+	wordsFrom(t.Root, "", yield)
+}
 
-// depthFirst sends all items on the tree in lexicographical order to its channel.
-type depthFirst struct {
-	tree    *MinTree
-	channel chan string
+// WordsWithPrefix iterates over every word stored in the tree that starts
+// with prefix, in lexicographical order. It descends directly to the
+// subtree rooted at prefix before enumerating, so words outside that
+// subtree are never visited.
+func (t *MinTree) WordsWithPrefix(prefix string, yield func(string) bool) {
+	node, ok := descendPrefix(t.Root, prefix)
+	if !ok {
+		return
+	}
+	wordsFrom(node, prefix, yield)
 }
 
-func newDepthFirst(tree *MinTree) *depthFirst {
-	return &depthFirst{
-		tree:    tree,
-		channel: make(chan string),
+// descendPrefix walks from node following each rune of prefix in turn,
+// returning the node reached and true, or (nil, false) if prefix isn't a
+// path in the tree. It's the shared prefix-descent primitive behind every
+// traversal that scopes itself to a subtree: WordsWithPrefix and the
+// treeCursor used by MergeWords/IntersectWords.
+func descendPrefix(node *MinTreeNode, prefix string) (*MinTreeNode, bool) {
+	for _, char := range prefix {
+		next, ok := node.Edges[char]
+		if !ok {
+			return nil, false
+		}
+		node = next
 	}
+	return node, true
 }
 
-func (df *depthFirst) start() {
-	df.search(df.tree.Root, "")
-	close(df.channel)
+// WordsInRange iterates over every word stored in the tree that falls
+// within [lo, hi] (inclusive, lexicographical order). Edges are visited in
+// sorted order: an edge whose prefix already sorts below lo is skipped
+// without descending into it, same as an edge that would push the
+// candidate word past hi, so only the subtree actually spanning [lo, hi]
+// is walked rather than the whole tree up to hi.
+func (t *MinTree) WordsInRange(lo, hi string, yield func(string) bool) {
+	wordsInRange(t.Root, "", lo, hi, yield)
 }
 
-func (df *depthFirst) search(node *MinTreeNode, word string) {
+// wordsFrom performs a depth-first, lexicographically ordered walk of the
+// subtree rooted at node, reporting every final node reached as word+path.
+// It returns false as soon as yield does, so callers further up the
+// recursion can stop descending immediately.
+func wordsFrom(node *MinTreeNode, word string, yield func(string) bool) bool {
 	if node.Final {
-		df.channel <- string(word)
-	} else {
-		for _, char := range sortKeys(node.Edges) {
-			df.search(node.Edges[char], word+string(char))
+		if !yield(word) {
+			return false
+		}
+	}
+	for _, char := range sortedEdges(node) {
+		if !wordsFrom(node.Edges[char], word+string(char), yield) {
+			return false
 		}
 	}
+	return true
 }
 
-func sortKeys(m map[rune]*MinTreeNode) (sorted []rune) {
-	for r := range m {
-		sorted = append(sorted, r)
+func wordsInRange(node *MinTreeNode, word, lo, hi string, yield func(string) bool) bool {
+	if node.Final && word >= lo && word <= hi {
+		if !yield(word) {
+			return false
+		}
 	}
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i] < sorted[j]
+	for _, char := range sortedEdges(node) {
+		next := word + string(char)
+		if next > hi {
+			// Edges are visited in ascending order, so every edge after
+			// this one will only push the candidate word further past hi.
+			break
+		}
+		if len(next) <= len(lo) && next < lo[:len(next)] {
+			// next, and every word under it, sorts below lo as a prefix;
+			// skip the subtree without descending into it, but keep
+			// scanning later (larger) edges at this level.
+			continue
+		}
+		if !wordsInRange(node.Edges[char], next, lo, hi, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// depthFirst sends all items on the tree in lexicographical order to its
+// channel.
+//
+// Deprecated: kept for callers that still expect a channel; prefer ranging
+// over MinTree.Words, which supports early termination without leaking a
+// goroutine.
+type depthFirst struct {
+	tree    *MinTree
+	channel chan string
+}
+
+func newDepthFirst(tree *MinTree) *depthFirst {
+	return &depthFirst{
+		tree:    tree,
+		channel: make(chan string),
+	}
+}
+
+func (df *depthFirst) start() {
+	df.tree.Words(func(word string) bool {
+		df.channel <- word
+		return true
 	})
-	return sorted
+	close(df.channel)
 }
@@ -0,0 +1,108 @@
+package mafsa
+
+import "testing"
+
+// buildTestTree builds a small non-minimized tree (no edge sharing) with
+// the given sorted, distinct words, final markers set on the last node of
+// each word. It's enough to exercise traversal order and pruning without
+// needing the real construction/minimization pipeline.
+func buildTestTree(words ...string) *MinTree {
+	root := &MinTreeNode{Edges: make(map[rune]*MinTreeNode)}
+	for _, word := range words {
+		node := root
+		for _, char := range word {
+			next, ok := node.Edges[char]
+			if !ok {
+				next = &MinTreeNode{Edges: make(map[rune]*MinTreeNode)}
+				node.Edges[char] = next
+			}
+			node = next
+		}
+		node.Final = true
+	}
+	return &MinTree{Root: root}
+}
+
+func TestWordsStopsEarly(t *testing.T) {
+	tree := buildTestTree("a", "b", "c", "d")
+	var seen []string
+	tree.Words(func(word string) bool {
+		seen = append(seen, word)
+		return len(seen) < 2
+	})
+	if want := []string{"a", "b"}; !equalStrings(seen, want) {
+		t.Errorf("Words did not stop after yield returned false: got %v, want %v", seen, want)
+	}
+}
+
+func TestWordsWithPrefix(t *testing.T) {
+	tree := buildTestTree("ant", "ants", "anteater", "apple", "banana")
+	var got []string
+	tree.WordsWithPrefix("ant", func(word string) bool {
+		got = append(got, word)
+		return true
+	})
+	if want := []string{"ant", "anteater", "ants"}; !equalStrings(got, want) {
+		t.Errorf("WordsWithPrefix(%q) = %v, want %v", "ant", got, want)
+	}
+}
+
+func TestWordsWithPrefixStopsEarly(t *testing.T) {
+	tree := buildTestTree("ant", "anteater", "ants")
+	var seen []string
+	tree.WordsWithPrefix("ant", func(word string) bool {
+		seen = append(seen, word)
+		return len(seen) < 2
+	})
+	if want := []string{"ant", "anteater"}; !equalStrings(seen, want) {
+		t.Errorf("WordsWithPrefix did not stop after yield returned false: got %v, want %v", seen, want)
+	}
+}
+
+func TestWordsWithPrefixNotFound(t *testing.T) {
+	tree := buildTestTree("apple", "banana")
+	var got []string
+	tree.WordsWithPrefix("zoo", func(word string) bool {
+		got = append(got, word)
+		return true
+	})
+	if len(got) != 0 {
+		t.Errorf("WordsWithPrefix(%q) = %v, want none", "zoo", got)
+	}
+}
+
+func TestWordsInRangeSkipsBelowLo(t *testing.T) {
+	tree := buildTestTree("aardvark", "apple", "zoo", "zoological", "zoop", "zoos", "zulu")
+	var got []string
+	tree.WordsInRange("zoo", "zoop", func(word string) bool {
+		got = append(got, word)
+		return true
+	})
+	if want := []string{"zoo", "zoological", "zoop"}; !equalStrings(got, want) {
+		t.Errorf("WordsInRange(%q, %q) = %v, want %v", "zoo", "zoop", got, want)
+	}
+}
+
+func TestWordsInRangeEmptyRange(t *testing.T) {
+	tree := buildTestTree("apple", "banana")
+	var got []string
+	tree.WordsInRange("m", "m", func(word string) bool {
+		got = append(got, word)
+		return true
+	})
+	if len(got) != 0 {
+		t.Errorf("WordsInRange over an empty range returned %v, want none", got)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
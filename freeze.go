@@ -0,0 +1,44 @@
+package mafsa
+
+import "sort"
+
+// Freeze precomputes and caches the sorted outgoing edges of every node
+// reachable from the tree's root, visiting each node exactly once
+// (NodeVisitorUnique), so that the depth-first, prefix, range, fuzzy and
+// merge walks in this package never sort a node's edge map on the hot
+// path. It is safe to call more than once, safe to call concurrently with
+// itself or with any read-only walk, and safe to skip: sortedEdges
+// populates each node's own cache lazily and atomically on first visit
+// regardless, so Freeze is purely a way to pay that cost up front instead
+// of during the first traversal.
+func (t *MinTree) Freeze() {
+	t.NodeVisitorUnique(nil, func(node *MinTreeNode, path []rune, edgeChar rune, depth int) {
+		sortedEdges(node)
+	})
+}
+
+// sortedEdges returns node's outgoing edge runes in ascending order,
+// computing and caching them in node.sortedEdges on first use. The cache
+// is an atomic.Pointer rather than a plain field so concurrent callers
+// (e.g. Words running on the same shared, read-only tree from multiple
+// goroutines) never race on it: if two goroutines compute the sorted
+// slice at once, both results are correct and CompareAndSwap picks
+// whichever one future callers see. Because the cache lives on the node
+// itself rather than in a map keyed by pointer, it is freed along with the
+// node: dropping or rebuilding a MinTree (e.g. while hot-reloading a
+// dictionary) releases the cache too, instead of pinning every node any
+// tree ever visited for the life of the process.
+func sortedEdges(node *MinTreeNode) []rune {
+	if cached := node.sortedEdges.Load(); cached != nil {
+		return *cached
+	}
+	sorted := make([]rune, 0, len(node.Edges))
+	for r := range node.Edges {
+		sorted = append(sorted, r)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	node.sortedEdges.CompareAndSwap(nil, &sorted)
+	return *node.sortedEdges.Load()
+}
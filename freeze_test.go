@@ -0,0 +1,49 @@
+package mafsa
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestFreezeIsIdempotentAndPreservesOrder(t *testing.T) {
+	tree := buildTestTree("ant", "bee", "cat")
+	tree.Freeze()
+	first := collect(tree.Words)
+	tree.Freeze() // re-entrant: must not corrupt the cache or the tree
+	second := collect(tree.Words)
+	want := []string{"ant", "bee", "cat"}
+	if !equalStrings(first, want) || !equalStrings(second, want) {
+		t.Errorf("Words after Freeze()/Freeze() = %v / %v, want %v", first, second, want)
+	}
+}
+
+func TestSortedEdgesCachedOnNode(t *testing.T) {
+	tree := buildTestTree("a", "b")
+	first := sortedEdges(tree.Root)
+	second := sortedEdges(tree.Root)
+	if &first[0] != &second[0] {
+		t.Errorf("sortedEdges returned a freshly computed slice on the second call instead of the cached one")
+	}
+}
+
+// TestWordsConcurrentUnfrozen exercises the documented concurrency
+// guarantee: Words (and anything else calling sortedEdges) may run on the
+// same unfrozen tree from multiple goroutines at once without racing,
+// because sortedEdges populates its cache with an atomic compare-and-swap
+// instead of a plain field write. Run with -race to verify.
+func TestWordsConcurrentUnfrozen(t *testing.T) {
+	tree := buildTestTree("ant", "ants", "bee", "bees", "cat")
+	want := []string{"ant", "ants", "bee", "bees", "cat"}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got := collect(tree.Words)
+			if !equalStrings(got, want) {
+				t.Errorf("concurrent Words() = %v, want %v", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+}
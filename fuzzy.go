@@ -0,0 +1,70 @@
+package mafsa
+
+// FuzzyMatch returns every word in the tree within maxDistance edits
+// (insertions, deletions, substitutions) of query, in lexicographical
+// order.
+func (t *MinTree) FuzzyMatch(query string, maxDistance int) []string {
+	var matches []string
+	t.FuzzyMatchFunc(query, maxDistance, func(word string, distance int) bool {
+		matches = append(matches, word)
+		return true
+	})
+	return matches
+}
+
+// FuzzyMatchFunc is the streaming form of FuzzyMatch: it calls yield with
+// each matching word and its edit distance from query, in lexicographical
+// order, stopping as soon as yield returns false.
+//
+// It walks the tree alongside a per-position Levenshtein DP row seeded at
+// the root with [0, 1, 2, ..., len(query)]. For each outgoing edge labelled
+// r, the next row is computed as next[0] = prev[0]+1 and
+// next[i] = min(next[i-1]+1, prev[i]+1, prev[i-1]+cost(query[i-1], r)), and
+// the recursion is pruned whenever every entry in the row exceeds
+// maxDistance, since no word reachable from that point can still be within
+// range. Because the tree is a DAG rather than a strict tree, the same node
+// may be visited more than once with different rows; this first cut does
+// not memoize on (node, row).
+func (t *MinTree) FuzzyMatchFunc(query string, maxDistance int, yield func(word string, distance int) bool) {
+	row := make([]int, len(query)+1)
+	for i := range row {
+		row[i] = i
+	}
+	fuzzySearch(t.Root, "", []rune(query), row, maxDistance, yield)
+}
+
+func fuzzySearch(node *MinTreeNode, word string, query []rune, prevRow []int, maxDistance int, yield func(word string, distance int) bool) bool {
+	if node.Final && prevRow[len(query)] <= maxDistance {
+		if !yield(word, prevRow[len(query)]) {
+			return false
+		}
+	}
+	for _, char := range sortedEdges(node) {
+		nextRow := make([]int, len(query)+1)
+		nextRow[0] = prevRow[0] + 1
+		for i := 1; i <= len(query); i++ {
+			cost := 1
+			if query[i-1] == char {
+				cost = 0
+			}
+			nextRow[i] = min(nextRow[i-1]+1, min(prevRow[i]+1, prevRow[i-1]+cost))
+		}
+		if minInt(nextRow) > maxDistance {
+			continue
+		}
+		if !fuzzySearch(node.Edges[char], word+string(char), query, nextRow, maxDistance, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func minInt(row []int) int {
+	m := row[0]
+	for _, v := range row[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,73 @@
+package mafsa
+
+import "testing"
+
+func TestFuzzyMatchZeroDistanceIsExact(t *testing.T) {
+	tree := buildTestTree("cat", "cats", "cut", "cot")
+	got := tree.FuzzyMatch("cat", 0)
+	if want := []string{"cat"}; !equalStrings(got, want) {
+		t.Errorf("FuzzyMatch(%q, 0) = %v, want %v", "cat", got, want)
+	}
+}
+
+func TestFuzzyMatchOneEdit(t *testing.T) {
+	tree := buildTestTree("cat", "cats", "cut", "cot", "dog")
+	got := tree.FuzzyMatch("cat", 1)
+	want := []string{"cat", "cats", "cot", "cut"}
+	if !equalStrings(got, want) {
+		t.Errorf("FuzzyMatch(%q, 1) = %v, want %v", "cat", got, want)
+	}
+}
+
+func TestFuzzyMatchDeletion(t *testing.T) {
+	// "cats" -> "cat" needs a single deletion, not an insertion or
+	// substitution, to exercise the nextRow[i-1]+1 term via a query
+	// longer than the matched word.
+	tree := buildTestTree("cat", "dog")
+	got := tree.FuzzyMatch("cats", 1)
+	if want := []string{"cat"}; !equalStrings(got, want) {
+		t.Errorf("FuzzyMatch(%q, 1) = %v, want %v", "cats", got, want)
+	}
+}
+
+func TestFuzzyMatchSubstitution(t *testing.T) {
+	// "bat" -> "cat"/"rat"/"mat" is a single substitution of the first
+	// rune, with no length change, so it only matches via the diagonal
+	// prevRow[i-1]+cost term, not an insertion or deletion.
+	tree := buildTestTree("cat", "rat", "mat", "dog")
+	got := tree.FuzzyMatch("bat", 1)
+	want := []string{"cat", "mat", "rat"}
+	if !equalStrings(got, want) {
+		t.Errorf("FuzzyMatch(%q, 1) = %v, want %v", "bat", got, want)
+	}
+}
+
+func TestFuzzyMatchNoneWithinDistance(t *testing.T) {
+	tree := buildTestTree("cat", "dog")
+	got := tree.FuzzyMatch("xyz", 1)
+	if len(got) != 0 {
+		t.Errorf("FuzzyMatch(%q, 1) = %v, want none", "xyz", got)
+	}
+}
+
+func TestFuzzyMatchFuncReportsDistance(t *testing.T) {
+	tree := buildTestTree("cat", "cats", "cot")
+	type pair struct {
+		word     string
+		distance int
+	}
+	var got []pair
+	tree.FuzzyMatchFunc("cat", 1, func(word string, distance int) bool {
+		got = append(got, pair{word, distance})
+		return true
+	})
+	want := []pair{{"cat", 0}, {"cats", 1}, {"cot", 1}}
+	if len(got) != len(want) {
+		t.Fatalf("FuzzyMatchFunc reported %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("FuzzyMatchFunc[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
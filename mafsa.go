@@ -0,0 +1,33 @@
+package mafsa
+
+import "sync/atomic"
+
+// MinTree is a minimized acyclic finite state automaton representing a
+// sorted set of words.
+type MinTree struct {
+	Root *MinTreeNode
+}
+
+// MinTreeNode is a single state in a MinTree. Because the automaton is
+// minimized, the same node can be reached via more than one path from the
+// root, so callers that need to visit each node exactly once should dedupe
+// on node identity (see NodeVisitorUnique).
+type MinTreeNode struct {
+	Final bool
+	Edges map[rune]*MinTreeNode
+
+	// sortedEdges caches the keys of Edges in ascending order. It's
+	// populated lazily the first time sortedEdges(node) is called, or
+	// eagerly for the whole tree by Freeze, so that repeated traversals
+	// reuse the cached slice instead of re-deriving sorted order from Edges
+	// on every visit. It lives on the node itself rather than in a separate
+	// cache keyed by pointer, so it's freed along with the node.
+	//
+	// It's an atomic.Pointer rather than a plain slice so that concurrent
+	// readers (e.g. two goroutines both calling Words on a tree that's
+	// shared, read-only, across requests) never race on it: a load sees
+	// either nil or a fully-built slice, never a partially-written one, and
+	// two goroutines racing to populate it both compute a correct slice and
+	// agree on whichever one wins the compare-and-swap.
+	sortedEdges atomic.Pointer[[]rune]
+}
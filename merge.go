@@ -0,0 +1,166 @@
+package mafsa
+
+import (
+	"container/heap"
+	"iter"
+)
+
+// treeCursor is the depth-first traversal state for a single tree: a stack
+// of frames mirroring the recursion in wordsFrom, plus the word the cursor
+// is currently positioned on. It starts from the same prefix-descent
+// primitive used by WordsWithPrefix (descendPrefix), and walks as a pull
+// cursor so a k-way merge can advance one tree at a time instead of
+// materializing its words.
+type treeCursor struct {
+	stack []cursorFrame
+	word  string
+}
+
+type cursorFrame struct {
+	node    *MinTreeNode
+	word    string
+	edges   []rune
+	idx     int
+	checked bool
+}
+
+// newTreeCursor descends to the subtree rooted at prefix and positions the
+// cursor on the first word in it, in lexicographical order. It returns nil
+// if the tree has no word starting with prefix.
+func newTreeCursor(tree *MinTree, prefix string) *treeCursor {
+	node, ok := descendPrefix(tree.Root, prefix)
+	if !ok {
+		return nil
+	}
+	c := &treeCursor{stack: []cursorFrame{{node: node, word: prefix, edges: sortedEdges(node)}}}
+	if !c.advance() {
+		return nil
+	}
+	return c
+}
+
+// advance moves the cursor to the next word in lexicographical order,
+// reporting whether one was found; c.word holds it on success.
+func (c *treeCursor) advance() bool {
+	for len(c.stack) > 0 {
+		top := &c.stack[len(c.stack)-1]
+		if !top.checked {
+			top.checked = true
+			if top.node.Final {
+				c.word = top.word
+				return true
+			}
+		}
+		if top.idx >= len(top.edges) {
+			c.stack = c.stack[:len(c.stack)-1]
+			continue
+		}
+		char := top.edges[top.idx]
+		top.idx++
+		child := top.node.Edges[char]
+		c.stack = append(c.stack, cursorFrame{node: child, word: top.word + string(char), edges: sortedEdges(child)})
+	}
+	c.word = ""
+	return false
+}
+
+// cursorHeap is a min-heap of treeCursors ordered by the word each is
+// currently positioned on.
+type cursorHeap []*treeCursor
+
+func (h cursorHeap) Len() int            { return len(h) }
+func (h cursorHeap) Less(i, j int) bool  { return h[i].word < h[j].word }
+func (h cursorHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *cursorHeap) Push(x interface{}) { *h = append(*h, x.(*treeCursor)) }
+func (h *cursorHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+func newCursorHeap(prefix string, trees []*MinTree) *cursorHeap {
+	h := make(cursorHeap, 0, len(trees))
+	for _, tree := range trees {
+		if c := newTreeCursor(tree, prefix); c != nil {
+			h = append(h, c)
+		}
+	}
+	heap.Init(&h)
+	return &h
+}
+
+// MergeWords lazily merges the words of multiple trees into a single
+// lexicographically ordered sequence, deduplicating words common to more
+// than one tree, without materializing or re-sorting each tree's
+// vocabulary. It maintains a min-heap of per-tree cursors, repeatedly
+// popping the smallest word, advancing every cursor currently positioned
+// on it, and re-pushing.
+func MergeWords(trees ...*MinTree) iter.Seq[string] {
+	return MergeWordsWithPrefix("", trees...)
+}
+
+// MergeWordsWithPrefix is like MergeWords, but scopes the merge to words
+// starting with prefix by descending each tree straight to the relevant
+// subtree before merging.
+func MergeWordsWithPrefix(prefix string, trees ...*MinTree) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		cursors := newCursorHeap(prefix, trees)
+		for cursors.Len() > 0 {
+			word := (*cursors)[0].word
+			if !yield(word) {
+				return
+			}
+			for cursors.Len() > 0 && (*cursors)[0].word == word {
+				c := (*cursors)[0]
+				if c.advance() {
+					heap.Fix(cursors, 0)
+				} else {
+					heap.Pop(cursors)
+				}
+			}
+		}
+	}
+}
+
+// IntersectWords lazily yields only the words present in every tree, in
+// lexicographical order, reusing the same k-way merge heap as MergeWords
+// but only emitting a word once every cursor is simultaneously positioned
+// on it.
+func IntersectWords(trees ...*MinTree) iter.Seq[string] {
+	return IntersectWordsWithPrefix("", trees...)
+}
+
+// IntersectWordsWithPrefix is like IntersectWords, scoped to words
+// starting with prefix.
+func IntersectWordsWithPrefix(prefix string, trees ...*MinTree) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		total := len(trees)
+		if total == 0 {
+			return
+		}
+		cursors := newCursorHeap(prefix, trees)
+		if cursors.Len() < total {
+			return // at least one tree has no word under prefix
+		}
+		for cursors.Len() == total {
+			word := (*cursors)[0].word
+			group := make([]*treeCursor, 0, total)
+			for cursors.Len() > 0 && (*cursors)[0].word == word {
+				group = append(group, heap.Pop(cursors).(*treeCursor))
+			}
+			if len(group) == total {
+				if !yield(word) {
+					return
+				}
+			}
+			for _, c := range group {
+				if !c.advance() {
+					return // one tree ran out of words; no later word can be in every tree
+				}
+				heap.Push(cursors, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package mafsa
+
+import "testing"
+
+func collect(seq func(yield func(string) bool)) []string {
+	var words []string
+	seq(func(word string) bool {
+		words = append(words, word)
+		return true
+	})
+	return words
+}
+
+func TestMergeWordsDedupesTies(t *testing.T) {
+	a := buildTestTree("ant", "bee")
+	b := buildTestTree("bee", "cat")
+	got := collect(MergeWords(a, b))
+	want := []string{"ant", "bee", "cat"}
+	if !equalStrings(got, want) {
+		t.Errorf("MergeWords = %v, want %v", got, want)
+	}
+}
+
+func TestIntersectWordsOnlyCommonWords(t *testing.T) {
+	a := buildTestTree("ant", "bee", "cat")
+	b := buildTestTree("bee", "cat", "dog")
+	c := buildTestTree("bee", "cat", "emu")
+	got := collect(IntersectWords(a, b, c))
+	want := []string{"bee", "cat"}
+	if !equalStrings(got, want) {
+		t.Errorf("IntersectWords = %v, want %v", got, want)
+	}
+}
+
+func TestIntersectWordsNoOverlapIsEmpty(t *testing.T) {
+	a := buildTestTree("ant")
+	b := buildTestTree("bee")
+	got := collect(IntersectWords(a, b))
+	if len(got) != 0 {
+		t.Errorf("IntersectWords with no common words = %v, want none", got)
+	}
+}
+
+func TestMergeWordsWithPrefixScopesToSubtree(t *testing.T) {
+	a := buildTestTree("ant", "ants", "bee")
+	b := buildTestTree("anteater", "cat")
+	got := collect(MergeWordsWithPrefix("ant", a, b))
+	want := []string{"ant", "anteater", "ants"}
+	if !equalStrings(got, want) {
+		t.Errorf("MergeWordsWithPrefix(%q) = %v, want %v", "ant", got, want)
+	}
+}
+
+func TestMergeWordsWithPrefixAbsentFromAllTrees(t *testing.T) {
+	a := buildTestTree("ant", "bee")
+	b := buildTestTree("cat", "dog")
+	got := collect(MergeWordsWithPrefix("zoo", a, b))
+	if len(got) != 0 {
+		t.Errorf("MergeWordsWithPrefix(%q) = %v, want none", "zoo", got)
+	}
+}
+
+func TestIntersectWordsWithPrefixScopesToSubtree(t *testing.T) {
+	a := buildTestTree("ant", "anteater", "ants", "bee")
+	b := buildTestTree("ant", "anteater", "cat")
+	got := collect(IntersectWordsWithPrefix("ant", a, b))
+	want := []string{"ant", "anteater"}
+	if !equalStrings(got, want) {
+		t.Errorf("IntersectWordsWithPrefix(%q) = %v, want %v", "ant", got, want)
+	}
+}
+
+func TestIntersectWordsWithPrefixAbsentFromAllTrees(t *testing.T) {
+	a := buildTestTree("ant", "bee")
+	b := buildTestTree("ant", "cat")
+	got := collect(IntersectWordsWithPrefix("zoo", a, b))
+	if len(got) != 0 {
+		t.Errorf("IntersectWordsWithPrefix(%q) = %v, want none", "zoo", got)
+	}
+}
@@ -0,0 +1,75 @@
+package mafsa
+
+// NodeVisitor walks the tree depth-first, calling pre on every node before
+// descending into its children and post (if non-nil) on every node again
+// after all of its children have been visited. Either callback may be nil
+// if that order isn't needed. path is the sequence of runes leading from
+// the root to node, edgeChar is the rune of the edge that was followed to
+// reach node (zero for the root), and depth is the number of edges
+// traversed so far.
+//
+// post is what makes per-subtree statistics (word count under a node,
+// subtree depth, etc.) possible: by the time post fires for a node, pre
+// and post have already both fired for everything beneath it, so post can
+// read results a caller accumulated for its children.
+//
+// Because nodes in a minimized tree are shared between many word paths, the
+// same *MinTreeNode can be passed to pre/post more than once, each time
+// with a different path/edgeChar/depth. Use NodeVisitorUnique if pre/post
+// should only see each node once regardless of how many paths reach it.
+func (t *MinTree) NodeVisitor(pre, post func(node *MinTreeNode, path []rune, edgeChar rune, depth int)) {
+	visitNodes(t.Root, nil, 0, 0, pre, post)
+}
+
+// NodeVisitorUnique is like NodeVisitor, but dedupes on node identity so
+// that pre and post are each called exactly once per node reachable from
+// the root, regardless of how many word paths pass through it. The path,
+// edgeChar and depth reported are those of the first path the walk used to
+// reach the node. Because a node is never descended into twice, the walk
+// costs O(nodes+edges) rather than O(paths to each node): a node is
+// visited, its callbacks fire, and its children are enqueued exactly once,
+// no matter how many times the node is shared.
+func (t *MinTree) NodeVisitorUnique(pre, post func(node *MinTreeNode, path []rune, edgeChar rune, depth int)) {
+	seen := make(map[*MinTreeNode]bool)
+	visitNodesUnique(t.Root, nil, 0, 0, pre, post, seen)
+}
+
+func visitNodes(node *MinTreeNode, path []rune, edgeChar rune, depth int, pre, post func(node *MinTreeNode, path []rune, edgeChar rune, depth int)) {
+	if pre != nil {
+		pre(node, path, edgeChar, depth)
+	}
+	for _, char := range sortedEdges(node) {
+		childPath := make([]rune, len(path)+1)
+		copy(childPath, path)
+		childPath[len(path)] = char
+		visitNodes(node.Edges[char], childPath, char, depth+1, pre, post)
+	}
+	if post != nil {
+		post(node, path, edgeChar, depth)
+	}
+}
+
+// visitNodesUnique is visitNodes with the dedup check at the top of the
+// recursion rather than in the callbacks, so that an already-visited node
+// is neither reported again nor descended into a second time. Checking
+// seen before recursing (not just before calling pre/post) is what keeps
+// the walk linear in the DAG's node and edge count: without it, a node
+// with k incoming paths has its entire subtree re-walked k times.
+func visitNodesUnique(node *MinTreeNode, path []rune, edgeChar rune, depth int, pre, post func(node *MinTreeNode, path []rune, edgeChar rune, depth int), seen map[*MinTreeNode]bool) {
+	if seen[node] {
+		return
+	}
+	seen[node] = true
+	if pre != nil {
+		pre(node, path, edgeChar, depth)
+	}
+	for _, char := range sortedEdges(node) {
+		childPath := make([]rune, len(path)+1)
+		copy(childPath, path)
+		childPath[len(path)] = char
+		visitNodesUnique(node.Edges[char], childPath, char, depth+1, pre, post, seen)
+	}
+	if post != nil {
+		post(node, path, edgeChar, depth)
+	}
+}
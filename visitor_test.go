@@ -0,0 +1,70 @@
+package mafsa
+
+import "testing"
+
+func TestNodeVisitorUniqueDedupes(t *testing.T) {
+	tree := buildTestTree("an", "at", "it")
+	visits := 0
+	tree.NodeVisitorUnique(func(node *MinTreeNode, path []rune, edgeChar rune, depth int) {
+		visits++
+	}, nil)
+	// root, a, n, t (under a), i, t (under i) -> 6 distinct node objects.
+	// buildTestTree is a plain trie with no edge sharing, so "at" and "it"
+	// each get their own final 't' node even though "a" is still only
+	// visited once despite being on the path to both "an" and "at".
+	if visits != 6 {
+		t.Errorf("NodeVisitorUnique visited %d nodes, want 6", visits)
+	}
+}
+
+// buildDiamondTree builds a true DAG, not just a trie: "ax", "ay", "bx" and
+// "by" all share one final node, so the shared node has four incoming
+// edges from two distinct parents, same as
+// suffix sharing in a real minimized automaton. If a walk fails to dedupe
+// on node identity before recursing, it revisits the shared node's
+// subtree once per incoming path instead of once total.
+func buildDiamondTree() (tree *MinTree, shared *MinTreeNode) {
+	shared = &MinTreeNode{Final: true, Edges: make(map[rune]*MinTreeNode)}
+	a := &MinTreeNode{Edges: map[rune]*MinTreeNode{'x': shared, 'y': shared}}
+	b := &MinTreeNode{Edges: map[rune]*MinTreeNode{'x': shared, 'y': shared}}
+	root := &MinTreeNode{Edges: map[rune]*MinTreeNode{'a': a, 'b': b}}
+	return &MinTree{Root: root}, shared
+}
+
+func TestNodeVisitorUniqueDoesNotRedescendSharedNode(t *testing.T) {
+	tree, shared := buildDiamondTree()
+	visits := 0
+	sharedVisits := 0
+	tree.NodeVisitorUnique(func(node *MinTreeNode, path []rune, edgeChar rune, depth int) {
+		visits++
+		if node == shared {
+			sharedVisits++
+		}
+	}, nil)
+	// root, a, b, shared -> 4 distinct nodes, even though shared is
+	// reachable via four distinct paths (a-x, a-y, b-x, b-y).
+	if visits != 4 {
+		t.Errorf("NodeVisitorUnique visited %d nodes, want 4", visits)
+	}
+	if sharedVisits != 1 {
+		t.Errorf("NodeVisitorUnique visited the shared node %d times, want 1", sharedVisits)
+	}
+}
+
+func TestNodeVisitorPostOrderSubtreeWordCount(t *testing.T) {
+	tree := buildTestTree("a", "an", "ant", "at")
+	counts := make(map[*MinTreeNode]int)
+	tree.NodeVisitor(nil, func(node *MinTreeNode, path []rune, edgeChar rune, depth int) {
+		count := 0
+		if node.Final {
+			count++
+		}
+		for _, child := range node.Edges {
+			count += counts[child]
+		}
+		counts[node] = count
+	})
+	if got := counts[tree.Root]; got != 4 {
+		t.Errorf("post-order word count at root = %d, want 4", got)
+	}
+}